@@ -2,16 +2,21 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/navidrome/navidrome/conf"
 	"github.com/navidrome/navidrome/log"
 	"github.com/navidrome/navidrome/model"
 	"github.com/navidrome/navidrome/server"
@@ -21,14 +26,51 @@ type contextKey string
 
 const requestInContext contextKey = "request"
 
-// storeRequestInContext is a middleware function that adds the full request object to the context.
+const defaultRequestTimeout = 30 * time.Second
+
+// storeRequestInContext is a middleware function that adds the full request object to the context,
+// and bounds it with the per-request deadline resolved by requestTimeout.
 func storeRequestInContext(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), requestInContext, r)
+
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout(r))
+		defer cancel()
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// requestTimeout resolves the deadline for a single request from the
+// Request-Timeout header or ?timeout= query param (both in seconds),
+// capped by conf.Server.MaxRequestTimeout so a client can only shrink it,
+// never extend it past what the server allows.
+func requestTimeout(r *http.Request) time.Duration {
+	max := conf.Server.MaxRequestTimeout
+	if max <= 0 {
+		max = defaultRequestTimeout
+	}
+
+	raw := r.Header.Get("Request-Timeout")
+	if raw == "" {
+		raw = r.URL.Query().Get("timeout")
+	}
+	if raw == "" {
+		return max
+	}
+
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return max
+	}
+
+	requested := time.Duration(seconds) * time.Second
+	if requested > max {
+		return max
+	}
+	return requested
+}
+
 func toAPITrack(mf model.MediaFile) Track {
 	return Track{
 		Type: ResourceTypeTrack,
@@ -97,6 +139,204 @@ func toAPITracks(mfs model.MediaFiles) []Track {
 	return tracks
 }
 
+func toAPIAlbum(al model.Album) Album {
+	return Album{
+		Type: ResourceTypeAlbum,
+		Id:   al.ID,
+		Attributes: &AlbumAttributes{
+			Name:       al.Name,
+			Artist:     al.AlbumArtist,
+			Genre:      p(al.Genre),
+			SongCount:  al.SongCount,
+			Duration:   al.Duration,
+			Year:       p(al.MaxYear),
+			RecordMbid: p(al.MbzAlbumID),
+		},
+	}
+}
+
+func toAPIArtist(ar model.Artist) Artist {
+	return Artist{
+		Type: ResourceTypeArtist,
+		Id:   ar.ID,
+		Attributes: &ArtistAttributes{
+			Name:       ar.Name,
+			ArtistMbid: p(ar.MbzArtistID),
+		},
+	}
+}
+
+// resourceInclude identifies the relationship types the `include` query
+// param can request for a track. Unknown values are silently ignored, as
+// JSON:API leaves that behavior up to the server.
+type resourceInclude string
+
+const (
+	includeAlbums  resourceInclude = "albums"
+	includeArtists resourceInclude = "artists"
+)
+
+// parseIncludes turns a comma-separated `include` query param into the set
+// of relationship types to side-load.
+func parseIncludes(include *string) map[resourceInclude]bool {
+	result := map[resourceInclude]bool{}
+	if include == nil {
+		return result
+	}
+	for _, name := range strings.Split(*include, ",") {
+		switch resourceInclude(strings.TrimSpace(name)) {
+		case includeAlbums:
+			result[includeAlbums] = true
+		case includeArtists:
+			result[includeArtists] = true
+		}
+	}
+	return result
+}
+
+// parseFieldsets turns the `fields[type]=a,b,c` query params into the set of
+// attribute names requested for each resource type. A type with no entry
+// means "all attributes", per the JSON:API sparse fieldsets spec.
+func parseFieldsets(query url.Values) map[string]map[string]bool {
+	fieldsets := map[string]map[string]bool{}
+	for key, values := range query {
+		if !strings.HasPrefix(key, "fields[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		resType := key[len("fields[") : len(key)-1]
+		names := map[string]bool{}
+		for _, v := range values {
+			for _, name := range strings.Split(v, ",") {
+				names[strings.TrimSpace(name)] = true
+			}
+		}
+		fieldsets[resType] = names
+	}
+	return fieldsets
+}
+
+// sparseAttributes re-encodes attrs as a JSON:API attributes object, keeping
+// only the keys requested for resType in fieldsets. If resType has no entry
+// in fieldsets, attrs is returned unfiltered.
+func sparseAttributes(attrs any, resType string, fieldsets map[string]map[string]bool) (map[string]any, error) {
+	raw, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+	var full map[string]any
+	if err := json.Unmarshal(raw, &full); err != nil {
+		return nil, err
+	}
+	allowed, ok := fieldsets[resType]
+	if !ok || len(allowed) == 0 {
+		return full, nil
+	}
+	for k := range full {
+		if !allowed[k] {
+			delete(full, k)
+		}
+	}
+	return full, nil
+}
+
+// albumIDs and artistIDs collect the distinct related IDs referenced by a
+// set of tracks, so they can be resolved with a single batched query each
+// instead of one query per track.
+func albumIDs(mfs model.MediaFiles) []string {
+	seen := map[string]bool{}
+	var ids []string
+	for _, mf := range mfs {
+		if mf.AlbumID != "" && !seen[mf.AlbumID] {
+			seen[mf.AlbumID] = true
+			ids = append(ids, mf.AlbumID)
+		}
+	}
+	return ids
+}
+
+func artistIDs(mfs model.MediaFiles) []string {
+	seen := map[string]bool{}
+	var ids []string
+	for _, mf := range mfs {
+		for _, id := range []string{mf.ArtistID, mf.AlbumArtistID} {
+			if id != "" && !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// buildIncluded resolves the relationships requested via `include` into a
+// JSON:API `included` array, batching one query per resource type rather
+// than fetching albums/artists one track at a time.
+func buildIncluded(ctx context.Context, ds model.DataStore, mfs model.MediaFiles, includes map[resourceInclude]bool, fieldsets map[string]map[string]bool) ([]ResourceObject, error) {
+	var included []ResourceObject
+
+	if includes[includeAlbums] {
+		ids := albumIDs(mfs)
+		if len(ids) > 0 {
+			albums, err := ds.Album(ctx).GetAll(model.QueryOptions{Filters: squirrel.Eq{"id": ids}})
+			if err != nil {
+				return nil, err
+			}
+			for _, al := range albums {
+				attrs, err := sparseAttributes(toAPIAlbum(al).Attributes, "album", fieldsets)
+				if err != nil {
+					return nil, err
+				}
+				included = append(included, ResourceObject{Type: ResourceTypeAlbum, Id: al.ID, Attributes: attrs})
+			}
+		}
+	}
+
+	if includes[includeArtists] {
+		ids := artistIDs(mfs)
+		if len(ids) > 0 {
+			artists, err := ds.Artist(ctx).GetAll(model.QueryOptions{Filters: squirrel.Eq{"id": ids}})
+			if err != nil {
+				return nil, err
+			}
+			for _, ar := range artists {
+				attrs, err := sparseAttributes(toAPIArtist(ar).Attributes, "artist", fieldsets)
+				if err != nil {
+					return nil, err
+				}
+				included = append(included, ResourceObject{Type: ResourceTypeArtist, Id: ar.ID, Attributes: attrs})
+			}
+		}
+	}
+
+	return included, nil
+}
+
+// toAPITrackDocument builds the JSON:API compound document for a set of
+// tracks: the primary `data` array (with sparse fieldsets applied) plus the
+// `included` array for any relationships requested via `include`. Related
+// albums/artists are fetched in one batched query per type rather than once
+// per track.
+func toAPITrackDocument(ctx context.Context, ds model.DataStore, mfs model.MediaFiles, include *string, query url.Values) ([]ResourceObject, []ResourceObject, error) {
+	fieldsets := parseFieldsets(query)
+
+	data := make([]ResourceObject, len(mfs))
+	for i, mf := range mfs {
+		track := toAPITrack(mf)
+		attrs, err := sparseAttributes(track.Attributes, "track", fieldsets)
+		if err != nil {
+			return nil, nil, err
+		}
+		data[i] = ResourceObject{Type: ResourceTypeTrack, Id: track.Id, Attributes: attrs, Relationships: track.Relationships}
+	}
+
+	included, err := buildIncluded(ctx, ds, mfs, parseIncludes(include), fieldsets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, included, nil
+}
+
 func p[T comparable](t T) *T {
 	var zero T
 	if t == zero {
@@ -113,33 +353,314 @@ func v[T comparable](p *T) T {
 	return *p
 }
 
+// mediaFileField pairs the client-facing (json) name of a model.MediaFile
+// field with the DB column (structs tag) squirrel and ORDER BY need, plus the
+// struct field index sortKeyValue uses to read it back out of a
+// model.MediaFile by reflection. The json and DB names are frequently
+// different (e.g. "trackNumber" vs "track_number"), which is why filter/
+// sort/cursor code must never pass the client-facing name straight into a
+// query - it has to be translated through sqlColumn first. fieldIndex is -1
+// for the synthetic "id" entry, whose value sortKeyValue reads via mf.ID
+// directly rather than by reflection.
+type mediaFileField struct {
+	jsonName   string
+	column     string
+	fieldIndex int
+}
+
+// mediaFileFields is the allowlist of fields that filter/sort params may
+// reference, derived from the json and structs tags on model.MediaFile. This
+// keeps the field name from filter[xxx]=field:value from reaching squirrel
+// (and therefore raw SQL) unchecked, and gives sqlColumn the DB column name
+// to translate it to.
+var mediaFileFields = sync.OnceValue(func() []mediaFileField {
+	fields := []mediaFileField{{jsonName: "id", column: "id", fieldIndex: -1}}
+	t := reflect.TypeOf(model.MediaFile{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonTag, ok := f.Tag.Lookup("json")
+		if !ok || jsonTag == "-" {
+			continue
+		}
+		column, ok := f.Tag.Lookup("structs")
+		if !ok || column == "-" {
+			continue
+		}
+		fields = append(fields, mediaFileField{jsonName: strings.SplitN(jsonTag, ",", 2)[0], column: column, fieldIndex: i})
+	}
+	return fields
+})
+
+var mediaFileFieldsByJSONName = sync.OnceValue(func() map[string]mediaFileField {
+	byName := make(map[string]mediaFileField, len(mediaFileFields()))
+	for _, f := range mediaFileFields() {
+		byName[f.jsonName] = f
+	}
+	return byName
+})
+
+var mediaFileFieldsByColumn = sync.OnceValue(func() map[string]mediaFileField {
+	byColumn := make(map[string]mediaFileField, len(mediaFileFields()))
+	for _, f := range mediaFileFields() {
+		byColumn[f.column] = f
+	}
+	return byColumn
+})
+
+// sqlColumn translates a client-facing field name (as used in filter[xxx]
+// and sort params) into the DB column name it's actually stored under,
+// rejecting anything outside the allowlist before it can reach squirrel.
+func sqlColumn(field string) (string, error) {
+	f, ok := mediaFileFieldsByJSONName()[field]
+	if !ok {
+		return "", fmt.Errorf("unknown filter field: %s", field)
+	}
+	return f.column, nil
+}
+
+// validateSQLColumn checks a column name that has already been through
+// sqlColumn (e.g. one pulled back out of a sort string by primarySortColumn)
+// against the same allowlist, keyed by DB column instead of json name.
+func validateSQLColumn(col string) error {
+	if _, ok := mediaFileFieldsByColumn()[col]; !ok {
+		return fmt.Errorf("unknown filter field: %s", col)
+	}
+	return nil
+}
+
 // toQueryOptions convert a params struct to a model.QueryOptions struct, to be used by the
-// GetAll and CountAll functions. It assumes all GetXxxxParams functions have the exact same structure.
-func toQueryOptions(ctx context.Context, params GetTracksParams) model.QueryOptions {
+// GetAll function, plus a second model.QueryOptions for CountAll that carries the same
+// filters but never the cursor predicate, since a cursor narrows the result set to one side
+// of a row and would make ?meta=totals report a shrinking count as the caller pages through.
+// It assumes all GetXxxxParams functions have the exact same structure. The returned error
+// is a validation error (e.g. an unknown filter or sort field) that callers should surface
+// through validationErrorHandler as a 400. The returned bool is true when the caller must
+// reverse the rows GetAll returns before rendering them (see reverseSortDirections).
+func toQueryOptions(ctx context.Context, params GetTracksParams) (options model.QueryOptions, countOptions model.QueryOptions, reverseResults bool, err error) {
 	var filters squirrel.And
-	parseFilter := func(fs *[]string, op func(f, v string) squirrel.Sqlizer) {
-		if fs != nil {
-			for _, f := range *fs {
-				parts := strings.SplitN(f, ":", 2)
-				filters = append(filters, op(parts[0], parts[1]))
+	parseFilter := func(fs *[]string, op func(f, v string) squirrel.Sqlizer) error {
+		if fs == nil {
+			return nil
+		}
+		for _, f := range *fs {
+			parts := strings.SplitN(f, ":", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid filter: %s", f)
+			}
+			col, err := sqlColumn(parts[0])
+			if err != nil {
+				return err
 			}
+			filters = append(filters, op(col, parts[1]))
 		}
+		return nil
+	}
+
+	sort, sortErr := toSortParams(params.Sort)
+
+	filterSteps := []error{
+		parseFilter(params.FilterEquals, func(f, v string) squirrel.Sqlizer { return squirrel.Eq{f: v} }),
+		parseFilter(params.FilterNotEquals, func(f, v string) squirrel.Sqlizer { return squirrel.NotEq{f: v} }),
+		parseFilter(params.FilterContains, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: "%" + v + "%"} }),
+		parseFilter(params.FilterStartsWith, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: v + "%"} }),
+		parseFilter(params.FilterEndsWith, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: "%" + v} }),
+		parseFilter(params.FilterGreaterThan, func(f, v string) squirrel.Sqlizer { return squirrel.Gt{f: v} }),
+		parseFilter(params.FilterGreaterOrEqual, func(f, v string) squirrel.Sqlizer { return squirrel.GtOrEq{f: v} }),
+		parseFilter(params.FilterLessThan, func(f, v string) squirrel.Sqlizer { return squirrel.Lt{f: v} }),
+		parseFilter(params.FilterLessOrEqual, func(f, v string) squirrel.Sqlizer { return squirrel.LtOrEq{f: v} }),
+		parseFilterIn(&filters, params.FilterIn),
+		parseFilterNullCheck(&filters, params.FilterIsNull, true),
+		parseFilterNullCheck(&filters, params.FilterIsNotNull, false),
+		parseFilterOr(&filters, params.FilterOr),
+		sortErr,
 	}
-	parseFilter(params.FilterEquals, func(f, v string) squirrel.Sqlizer { return squirrel.Eq{f: v} })
-	parseFilter(params.FilterContains, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: "%" + v + "%"} })
-	parseFilter(params.FilterStartsWith, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: v + "%"} })
-	parseFilter(params.FilterEndsWith, func(f, v string) squirrel.Sqlizer { return squirrel.Like{f: "%" + v} })
-	parseFilter(params.FilterGreaterThan, func(f, v string) squirrel.Sqlizer { return squirrel.Gt{f: v} })
-	parseFilter(params.FilterGreaterOrEqual, func(f, v string) squirrel.Sqlizer { return squirrel.GtOrEq{f: v} })
-	parseFilter(params.FilterLessThan, func(f, v string) squirrel.Sqlizer { return squirrel.Lt{f: v} })
-	parseFilter(params.FilterLessOrEqual, func(f, v string) squirrel.Sqlizer { return squirrel.LtOrEq{f: v} })
+	if err := errors.Join(filterSteps...); err != nil {
+		return model.QueryOptions{}, model.QueryOptions{}, false, err
+	}
+
+	// countFilters is captured before the cursor predicate is appended below,
+	// so CountAll always counts the full filtered set regardless of which
+	// page the cursor is currently on.
+	countFilters := append(squirrel.And{}, filters...)
+
 	offset := v(params.PageOffset)
 	limit := v(params.PageLimit)
-	sort, err := toSortParams(params.Sort)
+
+	// reverseResults tells the caller it must reverse the rows GetAll returns
+	// before rendering them: a page[cursor] moving backward (prev) has to
+	// scan with the ORDER BY flipped so LIMIT grabs the rows immediately
+	// preceding the cursor rather than the first N rows on the far side of it.
+	if params.PageCursor != nil {
+		cursor, decErr := decodeCursor(*params.PageCursor)
+		if decErr != nil {
+			log.Warn(ctx, "Ignoring invalid page[cursor] parameter", decErr)
+		} else if cursorFilter, filterErr := toCursorFilter(sort, cursor); filterErr != nil {
+			log.Warn(ctx, "Ignoring invalid page[cursor] parameter", filterErr)
+		} else {
+			filters = append(filters, cursorFilter)
+			offset = 0
+			if cursor.Backward {
+				sort = reverseSortDirections(sort)
+				reverseResults = true
+			}
+		}
+	}
+	if params.PageSize != nil {
+		limit = *params.PageSize
+	}
+
+	options = model.QueryOptions{Max: int(limit), Offset: int(offset), Filters: filters, Sort: sort}
+	countOptions = model.QueryOptions{Filters: countFilters}
+	return options, countOptions, reverseResults, nil
+}
+
+// reverseSortDirections flips every column in a sort string produced by
+// toSortParams (e.g. "title asc,year desc" -> "title desc,year asc"), so a
+// backward cursor page can scan in the opposite order and still grab the
+// rows nearest the cursor.
+func reverseSortDirections(sort string) string {
+	if sort == "" {
+		return sort
+	}
+	cols := strings.Split(sort, ",")
+	for i, col := range cols {
+		switch {
+		case strings.HasSuffix(col, " asc"):
+			cols[i] = strings.TrimSuffix(col, " asc") + " desc"
+		case strings.HasSuffix(col, " desc"):
+			cols[i] = strings.TrimSuffix(col, " desc") + " asc"
+		}
+	}
+	return strings.Join(cols, ",")
+}
+
+// parseFilterIn handles filter[in]=field:value1|value2|value3, mapped to a
+// squirrel.Eq with a slice so the driver emits a single `IN (...)` clause.
+func parseFilterIn(filters *squirrel.And, fs *[]string) error {
+	if fs == nil {
+		return nil
+	}
+	for _, f := range *fs {
+		parts := strings.SplitN(f, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid filter[in]: %s", f)
+		}
+		col, err := sqlColumn(parts[0])
+		if err != nil {
+			return err
+		}
+		*filters = append(*filters, squirrel.Eq{col: strings.Split(parts[1], "|")})
+	}
+	return nil
+}
+
+// parseFilterNullCheck handles filter[isNull]/filter[isNotNull]=field.
+func parseFilterNullCheck(filters *squirrel.And, fs *[]string, isNull bool) error {
+	if fs == nil {
+		return nil
+	}
+	for _, f := range *fs {
+		col, err := sqlColumn(strings.TrimSpace(f))
+		if err != nil {
+			return err
+		}
+		if isNull {
+			*filters = append(*filters, squirrel.Eq{col: nil})
+		} else {
+			*filters = append(*filters, squirrel.NotEq{col: nil})
+		}
+	}
+	return nil
+}
+
+// parseFilterOr handles filter[or]=field1:value1&filter[or]=field2:value2
+// (one field:value pair per entry), OR-combining its entries into a single
+// squirrel.Or that is then AND-ed with the rest of the filters.
+func parseFilterOr(filters *squirrel.And, fs *[]string) error {
+	if fs == nil {
+		return nil
+	}
+	var or squirrel.Or
+	for _, f := range *fs {
+		parts := strings.SplitN(f, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid filter[or] entry: %s", f)
+		}
+		col, err := sqlColumn(parts[0])
+		if err != nil {
+			return err
+		}
+		or = append(or, squirrel.Eq{col: parts[1]})
+	}
+	if len(or) > 0 {
+		*filters = append(*filters, or)
+	}
+	return nil
+}
+
+// pageCursor is the decoded form of an opaque page[cursor] value: the
+// primary sort-key value and ID of the row it seeks from, and the direction
+// to seek in. Keyset pagination seeks directly to the row after (or before)
+// it, so it stays just as cheap on the 1000th page as on the 1st, unlike
+// offset pagination.
+type pageCursor struct {
+	SortValue string `json:"s"`
+	ID        string `json:"id"`
+	// Backward is true for a "prev" cursor: seek toward rows before it
+	// instead of after it.
+	Backward bool `json:"b,omitempty"`
+}
+
+func encodeCursor(c pageCursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string) (pageCursor, error) {
+	var c pageCursor
+	raw, err := base64.RawURLEncoding.DecodeString(s)
 	if err != nil {
-		log.Warn(ctx, "Ignoring invalid sort parameter", err)
+		return c, fmt.Errorf("invalid page[cursor]: %w", err)
+	}
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return c, fmt.Errorf("invalid page[cursor]: %w", err)
+	}
+	return c, nil
+}
+
+// primarySortColumn returns the column name and direction of the first
+// entry in a sort string produced by toSortParams (e.g. "title asc").
+// Cursor pagination keys off this column, since it must match the ORDER BY
+// the result set is actually sorted by.
+func primarySortColumn(sort string) (col string, desc bool) {
+	if sort == "" {
+		return "id", false
+	}
+	first := strings.SplitN(sort, ",", 2)[0]
+	parts := strings.SplitN(first, " ", 2)
+	col = parts[0]
+	desc = len(parts) > 1 && parts[1] == "desc"
+	return col, desc
+}
+
+// toCursorFilter translates a decoded page[cursor] into a
+// `WHERE (sort_col, id) OP (?, ?)` predicate, keeping the same ordering sort
+// already established. A forward (next) cursor continues in the sort's own
+// direction; a backward (prev) cursor seeks the opposite way, which is why
+// the comparator depends on both desc and c.Backward, not just desc. sort is
+// expected to be the output of toSortParams, which already emits DB columns
+// translated through sqlColumn, but col is re-validated here too since it
+// flows straight into a raw SQL fragment.
+func toCursorFilter(sort string, c pageCursor) (squirrel.Sqlizer, error) {
+	col, desc := primarySortColumn(sort)
+	if err := validateSQLColumn(col); err != nil {
+		return nil, err
+	}
+	op := "<"
+	if desc == c.Backward {
+		op = ">"
 	}
-	return model.QueryOptions{Max: int(limit), Offset: int(offset), Filters: filters, Sort: sort}
+	return squirrel.Expr(fmt.Sprintf("(%s, id) %s (?, ?)", col, op), c.SortValue, c.ID), nil
 }
 
 var validSortPattern = regexp.MustCompile(`[a-zA-Z0-9_\-]`)
@@ -165,12 +686,25 @@ func toSortParams(sort *string) (string, error) {
 			return "", errors.New("invalid sort parameter: " + trimmedCol)
 		}
 
-		colName := strings.TrimSpace(trimmedCol[1:])
 		// Check for descending order
-		if strings.HasPrefix(trimmedCol, "-") {
-			resultCols = append(resultCols, fmt.Sprintf("%s desc", colName))
+		desc := strings.HasPrefix(trimmedCol, "-")
+		colName := trimmedCol
+		if desc {
+			colName = strings.TrimSpace(trimmedCol[1:])
+		}
+
+		// Translate the client-facing field name to its DB column (validating it
+		// against the same allowlist filter fields are checked against), since
+		// the result flows straight into a raw ORDER BY / cursor SQL fragment.
+		column, err := sqlColumn(colName)
+		if err != nil {
+			return "", err
+		}
+
+		if desc {
+			resultCols = append(resultCols, fmt.Sprintf("%s desc", column))
 		} else {
-			resultCols = append(resultCols, fmt.Sprintf("%s asc", trimmedCol))
+			resultCols = append(resultCols, fmt.Sprintf("%s asc", column))
 		}
 	}
 
@@ -184,11 +718,22 @@ func apiErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
 		res = ErrorObject{Status: p(strconv.Itoa(http.StatusForbidden)), Title: p(http.StatusText(http.StatusForbidden))}
 	case errors.Is(err, model.ErrNotFound):
 		res = ErrorObject{Status: p(strconv.Itoa(http.StatusNotFound)), Title: p(http.StatusText(http.StatusNotFound))}
+	case errors.Is(err, context.DeadlineExceeded):
+		res = ErrorObject{
+			Status: p(strconv.Itoa(http.StatusGatewayTimeout)),
+			Title:  p(http.StatusText(http.StatusGatewayTimeout)),
+			Detail: p("the request exceeded its deadline"),
+		}
 	default:
 		res = ErrorObject{Status: p(strconv.Itoa(http.StatusInternalServerError)), Title: p(http.StatusText(http.StatusInternalServerError))}
 	}
+	statusCode, convErr := strconv.Atoi(v(res.Status))
+	if convErr != nil {
+		statusCode = http.StatusInternalServerError
+	}
+
 	w.Header().Set("Content-Type", "application/vnd.api+json")
-	w.WriteHeader(403)
+	w.WriteHeader(statusCode)
 
 	_ = json.NewEncoder(w).Encode(ErrorList{[]ErrorObject{res}})
 }
@@ -283,6 +828,86 @@ func buildPaginationLinksAndMeta(totalItems int32, params GetTracksParams, resou
 	return links, meta
 }
 
+// sortKeyValue returns the value of mf for the DB column a cursor is keyed
+// on (as returned by primarySortColumn), so it can be embedded in a
+// page[cursor] link. col is looked up in mediaFileFieldsByColumn and read
+// out of mf by reflection, so it stays correct for every sortable field
+// without needing a case per column; time.Time fields are formatted in UTC
+// with a fixed-width nanosecond layout so lexical and chronological order
+// agree.
+func sortKeyValue(mf model.MediaFile, col string) string {
+	field, ok := mediaFileFieldsByColumn()[col]
+	if !ok || field.fieldIndex < 0 {
+		return mf.ID
+	}
+	value := reflect.ValueOf(mf).Field(field.fieldIndex)
+	if t, ok := value.Interface().(time.Time); ok {
+		return t.UTC().Format("2006-01-02T15:04:05.000000000Z07:00")
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// buildCursorPaginationLinksAndMeta builds JSON:API pagination links/meta
+// for page[cursor] requests. Unlike offset pagination it never needs a
+// COUNT(*) to produce next/prev, so total_pages is only computed (via
+// totalItems) when the caller explicitly asked for it with meta=totals.
+func buildCursorPaginationLinksAndMeta(mfs model.MediaFiles, params GetTracksParams, sort string, resourceName string, totalItems *int32) (PaginationLinks, PaginationMeta) {
+	pageSize := v(params.PageSize)
+	if pageSize == 0 {
+		pageSize = v(params.PageLimit)
+	}
+	col, _ := primarySortColumn(sort)
+
+	buildLink := func(mf model.MediaFile, backward bool) *string {
+		query := url.Values{}
+		query.Add("page[cursor]", encodeCursor(pageCursor{SortValue: sortKeyValue(mf, col), ID: mf.ID, Backward: backward}))
+		query.Add("page[size]", strconv.Itoa(int(pageSize)))
+		if params.Sort != nil {
+			query.Add("sort", *params.Sort)
+		}
+		if params.Include != nil {
+			query.Add("include", *params.Include)
+		}
+		link := resourceName + "?" + query.Encode()
+		return &link
+	}
+
+	meta := PaginationMeta{}
+	if totalItems != nil {
+		meta.TotalItems = totalItems
+		if pageSize > 0 {
+			totalPages := (*totalItems + pageSize - 1) / pageSize
+			meta.TotalPages = &totalPages
+		}
+	}
+
+	links := PaginationLinks{}
+	if len(mfs) > 0 {
+		// The first row of this page is where a "prev" cursor should seek
+		// backward from; the last row is where a "next" cursor continues.
+		links.Prev = buildLink(mfs[0], true)
+		if int32(len(mfs)) == pageSize {
+			links.Next = buildLink(mfs[len(mfs)-1], false)
+		}
+	}
+
+	return links, meta
+}
+
+// wantsTotals reports whether the request opted into the (expensive)
+// total_pages/total_items count via ?meta=totals, as documented for
+// page[cursor] requests.
+func wantsTotals(query url.Values) bool {
+	for _, v := range query["meta"] {
+		for _, part := range strings.Split(v, ",") {
+			if strings.TrimSpace(part) == "totals" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func baseResourceUrl(ctx context.Context, resourceName string) string {
 	r := ctx.Value(requestInContext).(*http.Request)
 	baseUrl, _ := url.JoinPath(spec.Servers[0].URL, resourceName)
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestToSortParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		sort    string
+		want    string
+		wantErr bool
+	}{
+		{"ascending column", "title", "title asc", false},
+		{"descending column", "-title", "title desc", false},
+		{"mixed columns", "title,-year", "title asc,year desc", false},
+		{"multi-word column translated to its db column", "-trackNumber", "track_number desc", false},
+		{"invalid prefix", "!title", "", true},
+		{"unknown column rejected", "title);DROP TABLE media_file;--", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toSortParams(&tt.sort)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toSortParams(%q) error = %v, wantErr %v", tt.sort, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("toSortParams(%q) = %q, want %q", tt.sort, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	want := pageCursor{SortValue: "Abbey Road", ID: "123", Backward: true}
+	got, err := decodeCursor(encodeCursor(want))
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if got != want {
+		t.Errorf("decodeCursor(encodeCursor(%+v)) = %+v", want, got)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	if _, err := decodeCursor("not-valid-base64!!"); err == nil {
+		t.Error("decodeCursor: expected error for malformed cursor, got nil")
+	}
+}
+
+func TestReverseSortDirections(t *testing.T) {
+	tests := map[string]string{
+		"":                    "",
+		"title asc":           "title desc",
+		"title desc":          "title asc",
+		"title asc,year desc": "title desc,year asc",
+	}
+	for in, want := range tests {
+		if got := reverseSortDirections(in); got != want {
+			t.Errorf("reverseSortDirections(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSqlColumn(t *testing.T) {
+	if col, err := sqlColumn("title"); err != nil || col != "title" {
+		t.Errorf("sqlColumn(%q) = (%q, %v), want (\"title\", nil)", "title", col, err)
+	}
+	if col, err := sqlColumn("trackNumber"); err != nil || col != "track_number" {
+		t.Errorf("sqlColumn(%q) = (%q, %v), want (\"track_number\", nil)", "trackNumber", col, err)
+	}
+	if _, err := sqlColumn("id); DROP TABLE media_file;--"); err == nil {
+		t.Error("sqlColumn: expected error for a field outside the allowlist, got nil")
+	}
+}
+
+func TestWantsTotals(t *testing.T) {
+	if wantsTotals(url.Values{}) {
+		t.Error("wantsTotals: expected false for empty query")
+	}
+	if !wantsTotals(url.Values{"meta": {"totals"}}) {
+		t.Error("wantsTotals: expected true for meta=totals")
+	}
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/navidrome/navidrome/model"
+)
+
+// Server implements the native API's generated server interface, backed by
+// a model.DataStore.
+type Server struct {
+	ds model.DataStore
+}
+
+func New(ds model.DataStore) *Server {
+	return &Server{ds: ds}
+}
+
+// GetTracks handles GET /tracks. It resolves filters/sort/pagination via
+// toQueryOptions, fetches the matching tracks, side-loads any relationships
+// requested via `include` and applies sparse fieldsets via
+// toAPITrackDocument, then attaches offset or cursor pagination links/meta
+// depending on which the request used.
+func (s *Server) GetTracks(w http.ResponseWriter, r *http.Request, params GetTracksParams) {
+	ctx := r.Context()
+
+	options, countOptions, reverseResults, err := toQueryOptions(ctx, params)
+	if err != nil {
+		validationErrorHandler(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	mfs, err := s.ds.MediaFile(ctx).GetAll(options)
+	if err != nil {
+		apiErrorHandler(w, r, err)
+		return
+	}
+	if reverseResults {
+		for i, j := 0, len(mfs)-1; i < j; i, j = i+1, j-1 {
+			mfs[i], mfs[j] = mfs[j], mfs[i]
+		}
+	}
+
+	data, included, err := toAPITrackDocument(ctx, s.ds, mfs, params.Include, r.URL.Query())
+	if err != nil {
+		apiErrorHandler(w, r, err)
+		return
+	}
+
+	links, meta, err := s.paginationLinksAndMeta(ctx, mfs, countOptions, params, r.URL.Query())
+	if err != nil {
+		apiErrorHandler(w, r, err)
+		return
+	}
+
+	_ = GetTracks200JSONResponse{
+		TracksResponse: TracksResponse{
+			Data:     data,
+			Included: &included,
+			Links:    &links,
+			Meta:     &meta,
+		},
+	}.VisitGetTracksResponse(w)
+}
+
+// paginationLinksAndMeta picks between offset and cursor pagination
+// depending on which the request used, only issuing the CountAll() needed
+// for total_items/total_pages when the caller actually asked for it
+// (offset pagination always needs it; cursor pagination only with
+// ?meta=totals, since that count is what makes offset paging expensive at
+// scale in the first place). countOptions must be the cursor-free
+// QueryOptions toQueryOptions returns, so the count reflects the whole
+// filtered set rather than just the rows on one side of the cursor.
+func (s *Server) paginationLinksAndMeta(ctx context.Context, mfs model.MediaFiles, countOptions model.QueryOptions, params GetTracksParams, query url.Values) (PaginationLinks, PaginationMeta, error) {
+	if params.PageCursor != nil || params.PageSize != nil {
+		sort, _ := toSortParams(params.Sort)
+
+		var totalItems *int32
+		if wantsTotals(query) {
+			count, err := s.ds.MediaFile(ctx).CountAll(countOptions)
+			if err != nil {
+				return PaginationLinks{}, PaginationMeta{}, err
+			}
+			total := int32(count)
+			totalItems = &total
+		}
+
+		links, meta := buildCursorPaginationLinksAndMeta(mfs, params, sort, "tracks", totalItems)
+		return links, meta, nil
+	}
+
+	count, err := s.ds.MediaFile(ctx).CountAll(countOptions)
+	if err != nil {
+		return PaginationLinks{}, PaginationMeta{}, err
+	}
+	links, meta := buildPaginationLinksAndMeta(int32(count), params, "tracks")
+	return links, meta, nil
+}